@@ -0,0 +1,62 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+)
+
+// SourceCapabilityConditionReady is the top-level condition for a
+// SourceCapability: it is True only when SourceCapabilityConditionIAMChecked
+// is True.
+const SourceCapabilityConditionReady apis.ConditionType = apis.ConditionReady
+
+// SourceCapabilityConditionIAMChecked is True when the IAM probe found every
+// role required by every registered source kind granted in the namespace. It
+// is False when any required role is missing or the probe itself failed.
+const SourceCapabilityConditionIAMChecked apis.ConditionType = "IAMChecked"
+
+var sourceCapabilityCondSet = apis.NewLivingConditionSet(SourceCapabilityConditionIAMChecked)
+
+// GetGroupVersionKind returns the GroupVersionKind for this type.
+func (c *SourceCapability) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("SourceCapability")
+}
+
+// ConditionSet returns the apis.ConditionSet of this resource, matching the
+// accessor name/signature duck.PubSubable already uses.
+func (c *SourceCapability) ConditionSet() *apis.ConditionSet {
+	return &sourceCapabilityCondSet
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (cs *SourceCapabilityStatus) InitializeConditions() {
+	sourceCapabilityCondSet.Manage(cs).InitializeConditions()
+}
+
+// MarkIAMChecked marks the IAMChecked condition to True.
+func (cs *SourceCapabilityStatus) MarkIAMChecked() {
+	sourceCapabilityCondSet.Manage(cs).MarkTrue(SourceCapabilityConditionIAMChecked)
+}
+
+// MarkIAMCheckFailed marks the IAMChecked condition to False with the given
+// reason and message.
+func (cs *SourceCapabilityStatus) MarkIAMCheckFailed(reason, messageFormat string, messageA ...interface{}) {
+	sourceCapabilityCondSet.Manage(cs).MarkFalse(SourceCapabilityConditionIAMChecked, reason, messageFormat, messageA...)
+}
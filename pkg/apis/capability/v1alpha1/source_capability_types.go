@@ -0,0 +1,101 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains API Schema definitions for the capability
+// v1alpha1 API group.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SourceCapability is a singleton, per-namespace summary of what event
+// source kinds are actually usable in that namespace: the CloudEvent types
+// each registered source kind can emit, whether the namespace's service
+// account holds the IAM roles those sources need, and whether cluster-wide
+// configuration they depend on (e.g. the Channel default) is present.
+//
+// SourceCapability is produced by the capability reconciler; it is never
+// written by end users.
+type SourceCapability struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SourceCapabilitySpec   `json:"spec"`
+	Status SourceCapabilityStatus `json:"status,omitempty"`
+}
+
+// Check that SourceCapability is a runtime.Object and can be an owner.
+var (
+	_ runtime.Object     = (*SourceCapability)(nil)
+	_ kmeta.OwnerRefable = (*SourceCapability)(nil)
+)
+
+// SourceCapabilitySpec is intentionally empty: a SourceCapability has no
+// user-configurable surface, it is entirely derived by the reconciler.
+type SourceCapabilitySpec struct{}
+
+// SourceKindCapability describes what a single registered PubSubable source
+// kind can do within the namespace.
+type SourceKindCapability struct {
+	// Kind is the source Kind, e.g. "Storage", "Scheduler", "PubSub", "Build".
+	Kind string `json:"kind"`
+
+	// EventTypes lists the CloudEvent types this source kind can emit.
+	EventTypes []string `json:"eventTypes,omitempty"`
+
+	// IAMRolesGranted is true if a dry-run testIamPermissions check found
+	// that the namespace's service account holds every IAM role this
+	// source kind requires.
+	IAMRolesGranted bool `json:"iamRolesGranted"`
+
+	// MissingIAMRoles lists the required roles that testIamPermissions did
+	// not report as granted, if IAMRolesGranted is false. If the check
+	// itself fails, every required role is listed.
+	MissingIAMRoles []string `json:"missingIamRoles,omitempty"`
+}
+
+// SourceCapabilityStatus is the observed state of a SourceCapability.
+type SourceCapabilityStatus struct {
+	// inherits duck/v1 Status, which currently provides:
+	// * ObservedGeneration
+	// * Conditions
+	duckv1.Status `json:",inline"`
+
+	// Sources enumerates, per registered PubSubable source kind, the
+	// CloudEvent types it can emit and whether its IAM prerequisites are
+	// satisfied.
+	Sources []SourceKindCapability `json:"sources,omitempty"`
+
+	// ChannelDefaulterConfigured is true if the ChannelDefaulter singleton
+	// consulted by ChannelSpec.SetDefaults has a default configured for
+	// this namespace.
+	ChannelDefaulterConfigured bool `json:"channelDefaulterConfigured"`
+}
+
+// SourceCapabilityList contains a list of SourceCapability resources.
+type SourceCapabilityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SourceCapability `json:"items"`
+}
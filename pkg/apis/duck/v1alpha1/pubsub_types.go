@@ -0,0 +1,70 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains the PubSub duck type shared by every source that
+// is reconciled via the generic pubsub_reconciler (Topic + PullSubscription).
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	duckv1beta1 "knative.dev/pkg/apis/duck/v1beta1"
+)
+
+// PubSubSpec is the common spec fields shared by every source that is
+// reconciled via the generic pubsub_reconciler.
+type PubSubSpec struct {
+	// This brings in the Sink and CloudEventOverrides fields along with
+	// the standard fields for a Source.
+	duckv1beta1.SourceSpec `json:",inline"`
+
+	// Secret is the credential used to create the Topic and
+	// PullSubscription backing this source.
+	// +optional
+	Secret *corev1.SecretKeySelector `json:"secret,omitempty"`
+
+	// Project is the ID of the Google Cloud Project that the Topic and
+	// PullSubscription will be created in.
+	// +optional
+	Project string `json:"project,omitempty"`
+
+	// VerificationPolicyRef, if set, requires that inbound messages be
+	// verified against the referenced VerificationPolicy before being
+	// dispatched to the sink.
+	// +optional
+	VerificationPolicyRef *corev1.ObjectReference `json:"verificationPolicyRef,omitempty"`
+}
+
+// PubSubStatus is the common status fields shared by every source that is
+// reconciled via the generic pubsub_reconciler.
+type PubSubStatus struct {
+	// inherits duck/v1beta1 Status, which currently provides:
+	// * ObservedGeneration
+	// * Conditions
+	duckv1beta1.SourceStatus `json:",inline"`
+
+	// ProjectID is the resolved ID of the Google Cloud Project.
+	// +optional
+	ProjectID string `json:"projectId,omitempty"`
+
+	// TopicID is the created Topic's ID.
+	// +optional
+	TopicID string `json:"topicId,omitempty"`
+
+	// SubscriptionID is the created PullSubscription's subscription ID.
+	// +optional
+	SubscriptionID string `json:"subscriptionId,omitempty"`
+}
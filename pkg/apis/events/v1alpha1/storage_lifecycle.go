@@ -0,0 +1,144 @@
+/*
+Copyright 2019 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	duckv1alpha1 "github.com/google/knative-gcp/pkg/apis/duck/v1alpha1"
+	"github.com/google/knative-gcp/pkg/duck"
+
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// StorageConditionTopicReady is True once the backing Topic is ready.
+	StorageConditionTopicReady apis.ConditionType = "TopicReady"
+	// StorageConditionPullSubscriptionReady is True once the backing
+	// PullSubscription is ready.
+	StorageConditionPullSubscriptionReady apis.ConditionType = "PullSubscriptionReady"
+	// StorageConditionPolicyReady is True once the VerificationPolicy this
+	// Storage references has been resolved. It is vacuously True when no
+	// VerificationPolicyRef is set; see InitializeConditions.
+	StorageConditionPolicyReady apis.ConditionType = "PolicyReady"
+)
+
+var storageCondSet = apis.NewLivingConditionSet(
+	StorageConditionTopicReady,
+	StorageConditionPullSubscriptionReady,
+	StorageConditionPolicyReady,
+)
+
+// Check that Storage implements duck.PubSubable.
+var _ duck.PubSubable = (*Storage)(nil)
+
+// PubSubSpec returns the PubSubSpec portion of the Spec. Storage does not
+// embed duckv1alpha1.PubSubSpec directly (it predates the PubSubable duck),
+// so this is synthesized from the Storage-specific fields that back it;
+// the pubsub_reconciler only reads it.
+func (s *Storage) PubSubSpec() *duckv1alpha1.PubSubSpec {
+	return &duckv1alpha1.PubSubSpec{
+		SourceSpec:            s.Spec.SourceSpec,
+		Secret:                storageSecret(&s.Spec),
+		VerificationPolicyRef: s.Spec.VerificationPolicyRef,
+	}
+}
+
+// storageSecret returns the secret that should be used to create the
+// PullSubscription backing this Storage, or nil if spec.CredentialsProvider
+// selects a provider (WorkloadIdentity, DefaultApplicationCredentials) that
+// doesn't use a Secret at all. Otherwise it's PullSubscriptionSecret if set,
+// falling back to GCSSecret.
+func storageSecret(spec *StorageSpec) *corev1.SecretKeySelector {
+	switch spec.CredentialsProvider {
+	case CredentialsProviderWorkloadIdentity, CredentialsProviderDefaultApplicationCredentials:
+		return nil
+	}
+	if spec.PullSubscriptionSecret != nil {
+		return spec.PullSubscriptionSecret
+	}
+	return &spec.GCSSecret
+}
+
+// PubSubStatus returns the PubSubStatus portion of the Status.
+func (s *Storage) PubSubStatus() *duckv1alpha1.PubSubStatus {
+	return &duckv1alpha1.PubSubStatus{
+		SourceStatus: s.Status.SourceStatus,
+	}
+}
+
+// VerificationPolicyRef returns the VerificationPolicy this Storage requires
+// inbound notifications to satisfy, or nil if none is configured.
+func (s *Storage) VerificationPolicyRef() *corev1.ObjectReference {
+	return s.Spec.VerificationPolicyRef
+}
+
+// ConditionSet returns the apis.ConditionSet tracked by this resource's
+// Status, as required by duck.PubSubable.
+func (s *Storage) ConditionSet() *apis.ConditionSet {
+	return &storageCondSet
+}
+
+// IsReady returns true if the resource is ready overall.
+func (ss *StorageStatus) IsReady() bool {
+	return storageCondSet.Manage(ss).IsHappy()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+// verificationPolicyRef should be the Storage's spec.VerificationPolicyRef:
+// when nil, StorageConditionPolicyReady is immediately marked True, since
+// there's no policy to resolve.
+func (ss *StorageStatus) InitializeConditions(verificationPolicyRef *corev1.ObjectReference) {
+	storageCondSet.Manage(ss).InitializeConditions()
+	if verificationPolicyRef == nil {
+		ss.MarkPolicyReady()
+	}
+}
+
+// MarkTopicReady marks the TopicReady condition to True.
+func (ss *StorageStatus) MarkTopicReady() {
+	storageCondSet.Manage(ss).MarkTrue(StorageConditionTopicReady)
+}
+
+// MarkTopicNotReady marks the TopicReady condition to False with the given
+// reason and message.
+func (ss *StorageStatus) MarkTopicNotReady(reason, messageFormat string, messageA ...interface{}) {
+	storageCondSet.Manage(ss).MarkFalse(StorageConditionTopicReady, reason, messageFormat, messageA...)
+}
+
+// MarkPullSubscriptionReady marks the PullSubscriptionReady condition to
+// True.
+func (ss *StorageStatus) MarkPullSubscriptionReady() {
+	storageCondSet.Manage(ss).MarkTrue(StorageConditionPullSubscriptionReady)
+}
+
+// MarkPullSubscriptionNotReady marks the PullSubscriptionReady condition to
+// False with the given reason and message.
+func (ss *StorageStatus) MarkPullSubscriptionNotReady(reason, messageFormat string, messageA ...interface{}) {
+	storageCondSet.Manage(ss).MarkFalse(StorageConditionPullSubscriptionReady, reason, messageFormat, messageA...)
+}
+
+// MarkPolicyReady marks the PolicyReady condition to True.
+func (ss *StorageStatus) MarkPolicyReady() {
+	storageCondSet.Manage(ss).MarkTrue(StorageConditionPolicyReady)
+}
+
+// MarkPolicyNotReady marks the PolicyReady condition to False with the given
+// reason and message.
+func (ss *StorageStatus) MarkPolicyNotReady(reason, messageFormat string, messageA ...interface{}) {
+	storageCondSet.Manage(ss).MarkFalse(StorageConditionPolicyReady, reason, messageFormat, messageA...)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2019 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+	duckv1beta1 "knative.dev/pkg/apis/duck/v1beta1"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Storage is the Schema for the GCS Source API.
+type Storage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StorageSpec   `json:"spec"`
+	Status StorageStatus `json:"status,omitempty"`
+}
+
+// Check that Storage is a runtime.Object and can be an owner.
+var (
+	_ runtime.Object     = (*Storage)(nil)
+	_ kmeta.OwnerRefable = (*Storage)(nil)
+)
+
+// CredentialsProviderType is the discriminator for how a Storage source
+// should obtain the credentials it uses to talk to GCS and Pub/Sub.
+type CredentialsProviderType string
+
+const (
+	// CredentialsProviderSecret instructs the source to read credentials out
+	// of GCSSecret / PullSubscriptionSecret. This is the default and
+	// preserves the historical behavior of this type.
+	CredentialsProviderSecret CredentialsProviderType = "Secret"
+
+	// CredentialsProviderWorkloadIdentity instructs the source to rely on a
+	// GCP service account bound to the receive adapter's Kubernetes service
+	// account via Workload Identity.
+	CredentialsProviderWorkloadIdentity CredentialsProviderType = "WorkloadIdentity"
+
+	// CredentialsProviderDefaultApplicationCredentials instructs the source
+	// to fall back to whatever Application Default Credentials are
+	// available in the receive adapter's environment.
+	CredentialsProviderDefaultApplicationCredentials CredentialsProviderType = "DefaultApplicationCredentials"
+)
+
+// StorageSpec defines the desired state of the Storage source.
+type StorageSpec struct {
+	// This brings in the Sink and CloudEventOverrides fields along with
+	// the standard fields for a Source.
+	duckv1beta1.SourceSpec `json:",inline"`
+
+	// Bucket to subscribe to.
+	Bucket string `json:"bucket,omitempty"`
+
+	// EventTypes is the list of CloudEvent types that the Storage source
+	// should subscribe to. If unset, all GCS notification event types are
+	// requested.
+	// +optional
+	EventTypes []string `json:"eventTypes,omitempty"`
+
+	// ObjectNamePrefix limits the notifications to objects with this prefix.
+	// +optional
+	ObjectNamePrefix string `json:"objectNamePrefix,omitempty"`
+
+	// PayloadFormat specifies the contentMode of the notification, either
+	// `JSON_API_V1` or `NONE`.
+	// +optional
+	PayloadFormat string `json:"payloadFormat,omitempty"`
+
+	// EndpointOverride names a non-default endpoint (e.g. a regional,
+	// VPC-restricted, or fake-gcs-server endpoint) to use in place of the
+	// public googleapis.com endpoints. It is validated as a URL only: it is
+	// not passed to the GCS or Pub/Sub clients or to the PullSubscription
+	// backing this source, which keep using the default endpoints.
+	// +optional
+	EndpointOverride *apis.URL `json:"endpointOverride,omitempty"`
+
+	// CredentialsProvider selects how the receive adapter obtains
+	// credentials to talk to GCS and Pub/Sub. Defaults to "Secret".
+	// +optional
+	CredentialsProvider CredentialsProviderType `json:"credentialsProvider,omitempty"`
+
+	// GCSSecret is the credential to use to create the Notification on the
+	// GCS bucket. The value of the secret entry must be a service account
+	// key in the JSON format (see
+	// https://cloud.google.com/iam/docs/creating-managing-service-account-keys).
+	// Only consulted when CredentialsProvider is "Secret" (or unset).
+	GCSSecret corev1.SecretKeySelector `json:"gcsSecret,omitempty"`
+
+	// PullSubscriptionSecret is the credential to use to create the
+	// PullSubscription for the GCS Notification. If not specified,
+	// GCSSecret will be used.
+	// Only consulted when CredentialsProvider is "Secret" (or unset).
+	// +optional
+	PullSubscriptionSecret *corev1.SecretKeySelector `json:"pullSubscriptionSecret,omitempty"`
+
+	// ServiceAccountName holds the name of the Kubernetes service account
+	// that the receive adapter runs as when CredentialsProvider is
+	// "WorkloadIdentity".
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// VerificationPolicyRef, if set, requires that inbound GCS
+	// notifications be verified against the referenced VerificationPolicy
+	// before being dispatched to the sink.
+	// +optional
+	VerificationPolicyRef *corev1.ObjectReference `json:"verificationPolicyRef,omitempty"`
+}
+
+// StorageStatus defines the observed state of the Storage source.
+type StorageStatus struct {
+	// inherits duck/v1beta1 Status, which currently provides:
+	// * ObservedGeneration
+	// * Conditions
+	duckv1beta1.SourceStatus `json:",inline"`
+}
+
+// GetGroupVersionKind returns the GroupVersionKind for this type.
+func (s *Storage) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("Storage")
+}
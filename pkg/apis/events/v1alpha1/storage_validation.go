@@ -0,0 +1,92 @@
+/*
+Copyright 2019 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+)
+
+func (s *Storage) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (ss *StorageSpec) Validate(ctx context.Context) *apis.FieldError {
+	var fe *apis.FieldError
+	if ss.Bucket == "" {
+		fe = fe.Also(apis.ErrMissingField("bucket"))
+	}
+
+	if errs := ss.SourceSpec.Validate(ctx); errs != nil {
+		fe = fe.Also(errs)
+	}
+
+	fe = fe.Also(ss.validateCredentials())
+
+	if ss.EndpointOverride != nil {
+		if errs := ss.EndpointOverride.Validate(ctx); errs != nil {
+			fe = fe.Also(errs.ViaField("endpointOverride"))
+		}
+	}
+
+	return fe
+}
+
+// validateCredentials checks that the secret fields line up with the
+// selected CredentialsProvider. GCSSecret / PullSubscriptionSecret are only
+// meaningful (and required to be well-formed) when CredentialsProvider is
+// "Secret", which is also the default for backwards compatibility.
+func (ss *StorageSpec) validateCredentials() *apis.FieldError {
+	var fe *apis.FieldError
+	switch ss.CredentialsProvider {
+	case "", CredentialsProviderSecret:
+		fe = fe.Also(validateSecretKeySelector(ss.GCSSecret).ViaField("gcsSecret"))
+		if ss.PullSubscriptionSecret != nil {
+			fe = fe.Also(validateSecretKeySelector(*ss.PullSubscriptionSecret).ViaField("pullSubscriptionSecret"))
+		}
+	case CredentialsProviderWorkloadIdentity, CredentialsProviderDefaultApplicationCredentials:
+		if ss.GCSSecret.Name != "" || ss.GCSSecret.Key != "" {
+			fe = fe.Also(apis.ErrDisallowedFields("gcsSecret"))
+		}
+		if ss.PullSubscriptionSecret != nil {
+			fe = fe.Also(apis.ErrDisallowedFields("pullSubscriptionSecret"))
+		}
+	default:
+		fe = fe.Also(apis.ErrInvalidValue(ss.CredentialsProvider, "credentialsProvider"))
+	}
+	return fe
+}
+
+// validateSecretKeySelector requires that, once either field of a
+// corev1.SecretKeySelector is set, both Name and Key are set. A completely
+// empty selector is treated as "not configured" and is not an error.
+func validateSecretKeySelector(secret corev1.SecretKeySelector) *apis.FieldError {
+	if secret.Name == "" && secret.Key == "" {
+		return nil
+	}
+	var fe *apis.FieldError
+	if secret.Name == "" {
+		fe = fe.Also(apis.ErrMissingField("name"))
+	}
+	if secret.Key == "" {
+		fe = fe.Also(apis.ErrMissingField("key"))
+	}
+	return fe
+}
@@ -250,6 +250,67 @@ func TestSpecValidationFields(t *testing.T) {
 			fe := apis.ErrMissingField("pullSubscriptionSecret.key")
 			return fe
 		}(),
+	}, {
+		name: "workload identity with gcs secret set",
+		spec: &StorageSpec{
+			Bucket: "my-test-bucket",
+			SourceSpec: duckv1beta1.SourceSpec{
+				Sink: apisv1alpha1.Destination{
+					ObjectReference: &corev1.ObjectReference{
+						APIVersion: "foo",
+						Kind:       "bar",
+						Namespace:  "baz",
+						Name:       "qux",
+					},
+				},
+			},
+			CredentialsProvider: CredentialsProviderWorkloadIdentity,
+			GCSSecret: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "gcs-test-secret"},
+				Key:                  "secret-test-key",
+			},
+		},
+		want: func() *apis.FieldError {
+			fe := apis.ErrDisallowedFields("gcsSecret")
+			return fe
+		}(),
+	}, {
+		name: "default application credentials, no secrets",
+		spec: &StorageSpec{
+			Bucket: "my-test-bucket",
+			SourceSpec: duckv1beta1.SourceSpec{
+				Sink: apisv1alpha1.Destination{
+					ObjectReference: &corev1.ObjectReference{
+						APIVersion: "foo",
+						Kind:       "bar",
+						Namespace:  "baz",
+						Name:       "qux",
+					},
+				},
+			},
+			CredentialsProvider: CredentialsProviderDefaultApplicationCredentials,
+		},
+		want: (*apis.FieldError)(nil),
+	}, {
+		name: "invalid credentials provider",
+		spec: &StorageSpec{
+			Bucket: "my-test-bucket",
+			SourceSpec: duckv1beta1.SourceSpec{
+				Sink: apisv1alpha1.Destination{
+					ObjectReference: &corev1.ObjectReference{
+						APIVersion: "foo",
+						Kind:       "bar",
+						Namespace:  "baz",
+						Name:       "qux",
+					},
+				},
+			},
+			CredentialsProvider: CredentialsProviderType("Bogus"),
+		},
+		want: func() *apis.FieldError {
+			fe := apis.ErrInvalidValue(CredentialsProviderType("Bogus"), "credentialsProvider")
+			return fe
+		}(),
 	}}
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
@@ -0,0 +1,64 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+)
+
+// PolicyConditionReady is the condition type used by sources that reference
+// a VerificationPolicy, set to True once the referenced policy (and its
+// keys) have been resolved successfully.
+const PolicyConditionReady apis.ConditionType = "PolicyReady"
+
+// verificationPolicyCondSet is the condition set used by VerificationPolicy
+// itself, tracking whether its keys resolve (e.g. SecretRef exists).
+var verificationPolicyCondSet = apis.NewLivingConditionSet(PolicyConditionReady)
+
+// GetGroupVersionKind returns the GroupVersionKind for this type.
+func (p *VerificationPolicy) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("VerificationPolicy")
+}
+
+// ConditionSet returns the apis.ConditionSet of this resource, matching the
+// accessor name/signature duck.PubSubable already uses.
+func (p *VerificationPolicy) ConditionSet() *apis.ConditionSet {
+	return &verificationPolicyCondSet
+}
+
+// IsReady returns true if the resource is ready overall.
+func (ps *VerificationPolicyStatus) IsReady() bool {
+	return verificationPolicyCondSet.Manage(ps).IsHappy()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (ps *VerificationPolicyStatus) InitializeConditions() {
+	verificationPolicyCondSet.Manage(ps).InitializeConditions()
+}
+
+// MarkPolicyReady marks the PolicyReady condition to True.
+func (ps *VerificationPolicyStatus) MarkPolicyReady() {
+	verificationPolicyCondSet.Manage(ps).MarkTrue(PolicyConditionReady)
+}
+
+// MarkPolicyNotReady marks the PolicyReady condition to False with the given
+// reason and message.
+func (ps *VerificationPolicyStatus) MarkPolicyNotReady(reason, messageFormat string, messageA ...interface{}) {
+	verificationPolicyCondSet.Manage(ps).MarkFalse(PolicyConditionReady, reason, messageFormat, messageA...)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains API Schema definitions for the policy v1alpha1 API group.
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VerificationPolicy defines the resources that require inbound message
+// signature verification, which public keys to verify against, and what to
+// do when verification fails.
+type VerificationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VerificationPolicySpec   `json:"spec"`
+	Status VerificationPolicyStatus `json:"status,omitempty"`
+}
+
+// Check that VerificationPolicy is a runtime.Object and can be an owner.
+var (
+	_ runtime.Object     = (*VerificationPolicy)(nil)
+	_ kmeta.OwnerRefable = (*VerificationPolicy)(nil)
+)
+
+// VerificationMode controls what happens when a message fails verification.
+type VerificationMode string
+
+const (
+	// VerificationModeEnforce drops messages that fail verification and
+	// surfaces a SignatureInvalid event.
+	VerificationModeEnforce VerificationMode = "enforce"
+	// VerificationModeWarn dispatches messages that fail verification but
+	// surfaces a SignatureInvalid event.
+	VerificationModeWarn VerificationMode = "warn"
+	// VerificationModeSkip disables verification entirely. This is useful
+	// for staging a policy before switching it to warn/enforce.
+	VerificationModeSkip VerificationMode = "skip"
+)
+
+// ResourceSelector selects the PubSubable resources a VerificationPolicy
+// applies to, within the VerificationPolicy's namespace.
+type ResourceSelector struct {
+	// NameRegex, if set, matches resources whose name satisfies the regular
+	// expression.
+	// +optional
+	NameRegex string `json:"nameRegex,omitempty"`
+
+	// Selector, if set, matches resources carrying all of the given labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// VerificationKey is a single public key a signature may be verified
+// against, supplied either inline or via a Secret.
+type VerificationKey struct {
+	// KeyData is an inline armored OpenPGP public key.
+	// +optional
+	KeyData string `json:"keyData,omitempty"`
+
+	// SecretRef points at a Secret key holding an armored OpenPGP public key.
+	// +optional
+	SecretRef *corev1.SecretKeySelector `json:"secretRef,omitempty"`
+}
+
+// VerificationPolicySpec defines the desired state of a VerificationPolicy.
+type VerificationPolicySpec struct {
+	// ResourceSelector lists the resources this policy applies to. A
+	// message must satisfy at least one selector to be subject to this
+	// policy.
+	ResourceSelector []ResourceSelector `json:"resourceSelector,omitempty"`
+
+	// Keys lists the public keys a message's signature may be verified
+	// against. Verification succeeds if any key matches.
+	Keys []VerificationKey `json:"keys,omitempty"`
+
+	// Mode controls what happens when verification fails. Defaults to
+	// "enforce".
+	// +optional
+	Mode VerificationMode `json:"mode,omitempty"`
+}
+
+// VerificationPolicyStatus defines the observed state of a
+// VerificationPolicy.
+type VerificationPolicyStatus struct {
+	// inherits duck/v1 Status, which currently provides:
+	// * ObservedGeneration
+	// * Conditions
+	duckv1.Status `json:",inline"`
+}
+
+// VerificationPolicyList contains a list of VerificationPolicy resources.
+type VerificationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VerificationPolicy `json:"items"`
+}
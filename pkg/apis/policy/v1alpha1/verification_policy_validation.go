@@ -0,0 +1,52 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+func (p *VerificationPolicy) Validate(ctx context.Context) *apis.FieldError {
+	return p.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (ps *VerificationPolicySpec) Validate(ctx context.Context) *apis.FieldError {
+	var fe *apis.FieldError
+	if len(ps.ResourceSelector) == 0 {
+		fe = fe.Also(apis.ErrMissingField("resourceSelector"))
+	}
+	if len(ps.Keys) == 0 {
+		fe = fe.Also(apis.ErrMissingField("keys"))
+	}
+	for i, k := range ps.Keys {
+		if k.KeyData == "" && k.SecretRef == nil {
+			fe = fe.Also(apis.ErrMissingOneOf("keyData", "secretRef").ViaFieldIndex("keys", i))
+		}
+		if k.KeyData != "" && k.SecretRef != nil {
+			fe = fe.Also(apis.ErrMultipleOneOf("keyData", "secretRef").ViaFieldIndex("keys", i))
+		}
+	}
+	switch ps.Mode {
+	case "", VerificationModeEnforce, VerificationModeWarn, VerificationModeSkip:
+		// valid
+	default:
+		fe = fe.Also(apis.ErrInvalidValue(ps.Mode, "mode"))
+	}
+	return fe
+}
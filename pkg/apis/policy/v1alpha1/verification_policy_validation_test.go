@@ -0,0 +1,89 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+)
+
+func TestVerificationPolicySpecValidation(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec *VerificationPolicySpec
+		want *apis.FieldError
+	}{{
+		name: "empty",
+		spec: &VerificationPolicySpec{},
+		want: func() *apis.FieldError {
+			fe := apis.ErrMissingField("resourceSelector", "keys")
+			return fe
+		}(),
+	}, {
+		name: "valid, inline key",
+		spec: &VerificationPolicySpec{
+			ResourceSelector: []ResourceSelector{{NameRegex: ".*"}},
+			Keys:             []VerificationKey{{KeyData: "-----BEGIN PGP PUBLIC KEY BLOCK-----"}},
+			Mode:             VerificationModeEnforce,
+		},
+		want: nil,
+	}, {
+		name: "valid, secret key",
+		spec: &VerificationPolicySpec{
+			ResourceSelector: []ResourceSelector{{NameRegex: ".*"}},
+			Keys: []VerificationKey{{SecretRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "keyring"},
+				Key:                  "pub.asc",
+			}}},
+		},
+		want: nil,
+	}, {
+		name: "key missing both keyData and secretRef",
+		spec: &VerificationPolicySpec{
+			ResourceSelector: []ResourceSelector{{NameRegex: ".*"}},
+			Keys:             []VerificationKey{{}},
+		},
+		want: func() *apis.FieldError {
+			fe := apis.ErrMissingOneOf("keyData", "secretRef").ViaFieldIndex("keys", 0)
+			return fe
+		}(),
+	}, {
+		name: "invalid mode",
+		spec: &VerificationPolicySpec{
+			ResourceSelector: []ResourceSelector{{NameRegex: ".*"}},
+			Keys:             []VerificationKey{{KeyData: "key"}},
+			Mode:             VerificationMode("bogus"),
+		},
+		want: func() *apis.FieldError {
+			fe := apis.ErrInvalidValue(VerificationMode("bogus"), "mode")
+			return fe
+		}(),
+	}}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.spec.Validate(context.TODO())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Errorf("%s: Validate VerificationPolicySpec (-want, +got) = %v", test.name, diff)
+			}
+		})
+	}
+}
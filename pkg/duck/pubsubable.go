@@ -19,6 +19,7 @@ package duck
 import (
 	duckv1alpha1 "github.com/google/knative-gcp/pkg/apis/duck/v1alpha1"
 
+	corev1 "k8s.io/api/core/v1"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/kmeta"
 )
@@ -31,10 +32,15 @@ type PubSubable interface {
 	PubSubSpec() *duckv1alpha1.PubSubSpec
 	// PubSubStatus returns the PubSubStatus portion of the Status.
 	PubSubStatus() *duckv1alpha1.PubSubStatus
+	// VerificationPolicyRef returns a reference to the VerificationPolicy
+	// that inbound messages must satisfy before being dispatched to the
+	// sink, or nil if the embedding object does not require verification.
+	VerificationPolicyRef() *corev1.ObjectReference
 	// ConditionSet returns the apis.ConditionSet of the embedding object
 	// This Set must have the following Conditions defined in it.
 	// "TopicReady",
 	// "PullSubscriptionReady",
+	// "PolicyReady" (only required to be True when VerificationPolicyRef is non-nil),
 	// Which will be set appropriately automagically by the pubsub_reconciler.go
 	ConditionSet() *apis.ConditionSet
 }
@@ -0,0 +1,73 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capability
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	capabilityv1alpha1 "github.com/google/knative-gcp/pkg/apis/capability/v1alpha1"
+)
+
+// Client abstracts the generated SourceCapability clientset method set that
+// Reconciler needs, so it can be unit tested without a real API server.
+type Client interface {
+	// Get returns an apierrors.IsNotFound error if no SourceCapability named
+	// name exists in namespace.
+	Get(ctx context.Context, namespace, name string) (*capabilityv1alpha1.SourceCapability, error)
+	Create(ctx context.Context, sc *capabilityv1alpha1.SourceCapability) (*capabilityv1alpha1.SourceCapability, error)
+	UpdateStatus(ctx context.Context, sc *capabilityv1alpha1.SourceCapability) (*capabilityv1alpha1.SourceCapability, error)
+}
+
+// Reconciler publishes the per-namespace singleton SourceCapability: it
+// computes the namespace's status with StatusBuilder and creates or updates
+// the SourceCapability object through Client to match.
+//
+// The watch/informer wiring that should drive ReconcileNamespace off
+// PubSubable add/update/delete events (so this runs as an actual controller
+// work queue rather than on demand) is follow-up work and is not included
+// here.
+type Reconciler struct {
+	Status *StatusBuilder
+	Client Client
+}
+
+// ReconcileNamespace computes namespace's capability status and creates or
+// updates its singleton SourceCapability object to match.
+func (r *Reconciler) ReconcileNamespace(ctx context.Context, namespace, serviceAccount string) error {
+	status := r.Status.BuildStatus(ctx, namespace, serviceAccount)
+
+	sc, err := r.Client.Get(ctx, namespace, capabilityv1alpha1.SourceCapabilityName)
+	if apierrors.IsNotFound(err) {
+		sc = &capabilityv1alpha1.SourceCapability{}
+		sc.Namespace = namespace
+		sc.Name = capabilityv1alpha1.SourceCapabilityName
+		if sc, err = r.Client.Create(ctx, sc); err != nil {
+			return fmt.Errorf("creating SourceCapability %s/%s: %w", namespace, capabilityv1alpha1.SourceCapabilityName, err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("getting SourceCapability %s/%s: %w", namespace, capabilityv1alpha1.SourceCapabilityName, err)
+	}
+
+	sc.Status = *status
+	if _, err := r.Client.UpdateStatus(ctx, sc); err != nil {
+		return fmt.Errorf("updating SourceCapability %s/%s status: %w", namespace, capabilityv1alpha1.SourceCapabilityName, err)
+	}
+	return nil
+}
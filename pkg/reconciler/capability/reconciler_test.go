@@ -0,0 +1,115 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capability
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	capabilityv1alpha1 "github.com/google/knative-gcp/pkg/apis/capability/v1alpha1"
+)
+
+type fakeClient struct {
+	objs      map[string]*capabilityv1alpha1.SourceCapability
+	getErr    error
+	createErr error
+	updateErr error
+}
+
+func (f *fakeClient) Get(_ context.Context, namespace, name string) (*capabilityv1alpha1.SourceCapability, error) {
+	if f.getErr != nil {
+		return nil, f.getErr
+	}
+	sc, ok := f.objs[namespace]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: "capability.cloud.google.com", Resource: "sourcecapabilities"}, name)
+	}
+	return sc, nil
+}
+
+func (f *fakeClient) Create(_ context.Context, sc *capabilityv1alpha1.SourceCapability) (*capabilityv1alpha1.SourceCapability, error) {
+	if f.createErr != nil {
+		return nil, f.createErr
+	}
+	if f.objs == nil {
+		f.objs = map[string]*capabilityv1alpha1.SourceCapability{}
+	}
+	f.objs[sc.Namespace] = sc
+	return sc, nil
+}
+
+func (f *fakeClient) UpdateStatus(_ context.Context, sc *capabilityv1alpha1.SourceCapability) (*capabilityv1alpha1.SourceCapability, error) {
+	if f.updateErr != nil {
+		return nil, f.updateErr
+	}
+	f.objs[sc.Namespace] = sc
+	return sc, nil
+}
+
+func TestReconcileNamespace(t *testing.T) {
+	registrations := []SourceKindRegistration{{
+		Kind:       "Storage",
+		EventTypes: []string{"com.google.cloud.storage.object.finalize"},
+		IAMRoles:   []string{"roles/pubsub.editor"},
+	}}
+
+	tests := []struct {
+		name    string
+		client  *fakeClient
+		wantErr bool
+	}{{
+		name:   "creates when missing",
+		client: &fakeClient{},
+	}, {
+		name:   "updates when present",
+		client: &fakeClient{objs: map[string]*capabilityv1alpha1.SourceCapability{"ns": {}}},
+	}, {
+		name:    "create fails",
+		client:  &fakeClient{createErr: errors.New("boom")},
+		wantErr: true,
+	}, {
+		name:    "get fails with a non-NotFound error",
+		client:  &fakeClient{getErr: errors.New("etcdserver: request timed out")},
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &Reconciler{
+				Status: &StatusBuilder{
+					Registrations: registrations,
+					IAM:           &fakeIAMTester{granted: map[string][]string{"default": {"roles/pubsub.editor"}}},
+				},
+				Client: test.client,
+			}
+
+			err := r.ReconcileNamespace(context.Background(), "ns", "default")
+			if (err != nil) != test.wantErr {
+				t.Errorf("ReconcileNamespace() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err == nil {
+				if _, ok := test.client.objs["ns"]; !ok {
+					t.Errorf("expected SourceCapability to be published for namespace %q", "ns")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package capability builds and publishes the per-namespace singleton
+// SourceCapability resource: it enumerates the PubSubable source kinds
+// registered with the cluster and summarizes the CloudEvent types they can
+// emit, whether the namespace's service account holds the IAM roles they
+// need, and whether cluster configuration they depend on is in place.
+package capability
+
+import (
+	"context"
+
+	capabilityv1alpha1 "github.com/google/knative-gcp/pkg/apis/capability/v1alpha1"
+)
+
+// SourceKindRegistration describes a PubSubable source kind the capability
+// StatusBuilder knows how to probe. StatusBuilder.Registrations holds one of
+// these per source kind.
+type SourceKindRegistration struct {
+	// Kind is the source Kind, e.g. "Storage", "Scheduler", "PubSub", "Build".
+	Kind string
+	// EventTypes lists the CloudEvent types this source kind can emit.
+	EventTypes []string
+	// IAMRoles lists the IAM roles this source kind's receive adapter needs
+	// in order to run.
+	IAMRoles []string
+}
+
+// IAMPermissionTester abstracts a dry-run IAM permission check (e.g. GCP
+// Pub/Sub's projects.testIamPermissions) so StatusBuilder can be unit
+// tested without a live GCP project.
+type IAMPermissionTester interface {
+	// TestIAMPermissions returns the subset of roles that serviceAccount
+	// actually holds.
+	TestIAMPermissions(ctx context.Context, serviceAccount string, roles []string) (granted []string, err error)
+}
+
+// ChannelDefaulterChecker reports whether the ChannelDefaulter singleton
+// consulted by ChannelSpec.SetDefaults has a default configured for a given
+// namespace.
+type ChannelDefaulterChecker interface {
+	HasDefault(namespace string) bool
+}
+
+// StatusBuilder computes a namespace's SourceCapabilityStatus from the set
+// of registered source kinds. It is the pure, side-effect-free half of the
+// capability controller; Reconciler (reconciler.go) is what actually writes
+// the result to the cluster.
+type StatusBuilder struct {
+	Registrations    []SourceKindRegistration
+	IAM              IAMPermissionTester
+	ChannelDefaulter ChannelDefaulterChecker
+}
+
+// BuildStatus probes every registered source kind and returns the resulting
+// SourceCapabilityStatus for the given namespace, run as serviceAccount.
+func (r *StatusBuilder) BuildStatus(ctx context.Context, namespace, serviceAccount string) *capabilityv1alpha1.SourceCapabilityStatus {
+	status := &capabilityv1alpha1.SourceCapabilityStatus{}
+	status.InitializeConditions()
+
+	allGranted := true
+	for _, reg := range r.Registrations {
+		sc := capabilityv1alpha1.SourceKindCapability{
+			Kind:       reg.Kind,
+			EventTypes: reg.EventTypes,
+		}
+
+		granted, err := r.IAM.TestIAMPermissions(ctx, serviceAccount, reg.IAMRoles)
+		if err != nil {
+			sc.MissingIAMRoles = reg.IAMRoles
+		} else {
+			sc.MissingIAMRoles = missingRoles(reg.IAMRoles, granted)
+		}
+		sc.IAMRolesGranted = len(sc.MissingIAMRoles) == 0
+		if !sc.IAMRolesGranted {
+			allGranted = false
+		}
+
+		status.Sources = append(status.Sources, sc)
+	}
+
+	status.ChannelDefaulterConfigured = r.ChannelDefaulter != nil && r.ChannelDefaulter.HasDefault(namespace)
+
+	if allGranted {
+		status.MarkIAMChecked()
+	} else {
+		status.MarkIAMCheckFailed("IAMRolesMissing", "one or more registered source kinds are missing required IAM roles in namespace %q", namespace)
+	}
+	return status
+}
+
+// missingRoles returns the subset of required that isn't present in granted.
+func missingRoles(required, granted []string) []string {
+	grantedSet := make(map[string]bool, len(granted))
+	for _, g := range granted {
+		grantedSet[g] = true
+	}
+	var out []string
+	for _, req := range required {
+		if !grantedSet[req] {
+			out = append(out, req)
+		}
+	}
+	return out
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capability
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	capabilityv1alpha1 "github.com/google/knative-gcp/pkg/apis/capability/v1alpha1"
+)
+
+type fakeIAMTester struct {
+	granted map[string][]string // keyed by service account
+	err     error
+}
+
+func (f *fakeIAMTester) TestIAMPermissions(_ context.Context, serviceAccount string, roles []string) ([]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.granted[serviceAccount], nil
+}
+
+type fakeChannelDefaulterChecker struct {
+	namespaces map[string]bool
+}
+
+func (f *fakeChannelDefaulterChecker) HasDefault(namespace string) bool {
+	return f.namespaces[namespace]
+}
+
+func TestBuildStatus(t *testing.T) {
+	registrations := []SourceKindRegistration{{
+		Kind:       "Storage",
+		EventTypes: []string{"com.google.cloud.storage.object.finalize"},
+		IAMRoles:   []string{"roles/pubsub.editor"},
+	}, {
+		Kind:       "Scheduler",
+		EventTypes: []string{"com.google.cloud.scheduler.job.execute"},
+		IAMRoles:   []string{"roles/cloudscheduler.admin"},
+	}}
+
+	tests := []struct {
+		name             string
+		iam              IAMPermissionTester
+		channelDefaulter ChannelDefaulterChecker
+		namespace        string
+		wantChannel      bool
+		wantIAMChecked   bool
+		wantMissing      map[string][]string
+	}{{
+		name: "all roles granted",
+		iam: &fakeIAMTester{granted: map[string][]string{
+			"default": {"roles/pubsub.editor", "roles/cloudscheduler.admin"},
+		}},
+		channelDefaulter: &fakeChannelDefaulterChecker{namespaces: map[string]bool{"ns": true}},
+		namespace:        "ns",
+		wantChannel:      true,
+		wantIAMChecked:   true,
+		wantMissing: map[string][]string{
+			"Storage":   nil,
+			"Scheduler": nil,
+		},
+	}, {
+		name: "scheduler role missing",
+		iam: &fakeIAMTester{granted: map[string][]string{
+			"default": {"roles/pubsub.editor"},
+		}},
+		channelDefaulter: &fakeChannelDefaulterChecker{},
+		namespace:        "ns",
+		wantChannel:      false,
+		wantIAMChecked:   false,
+		wantMissing: map[string][]string{
+			"Storage":   nil,
+			"Scheduler": {"roles/cloudscheduler.admin"},
+		},
+	}, {
+		name:             "IAM check itself fails closed to missing",
+		iam:              &fakeIAMTester{err: errors.New("permission denied")},
+		channelDefaulter: &fakeChannelDefaulterChecker{},
+		namespace:        "ns",
+		wantChannel:      false,
+		wantIAMChecked:   false,
+		wantMissing: map[string][]string{
+			"Storage":   {"roles/pubsub.editor"},
+			"Scheduler": {"roles/cloudscheduler.admin"},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &StatusBuilder{
+				Registrations:    registrations,
+				IAM:              test.iam,
+				ChannelDefaulter: test.channelDefaulter,
+			}
+			status := r.BuildStatus(context.Background(), test.namespace, "default")
+
+			if status.ChannelDefaulterConfigured != test.wantChannel {
+				t.Errorf("ChannelDefaulterConfigured = %v, want %v", status.ChannelDefaulterConfigured, test.wantChannel)
+			}
+			if got := status.GetCondition(capabilityv1alpha1.SourceCapabilityConditionIAMChecked).IsTrue(); got != test.wantIAMChecked {
+				t.Errorf("IAMChecked = %v, want %v", got, test.wantIAMChecked)
+			}
+
+			gotMissing := map[string][]string{}
+			for _, sc := range status.Sources {
+				gotMissing[sc.Kind] = sc.MissingIAMRoles
+			}
+			if diff := cmp.Diff(test.wantMissing, gotMissing, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("missing IAM roles (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// reservedResourceNames cannot be set as "extended" resources through
+// WithExtendedResource; they have their own dedicated request/limit knobs.
+var reservedResourceNames = map[corev1.ResourceName]bool{
+	corev1.ResourceCPU:              true,
+	corev1.ResourceMemory:           true,
+	corev1.ResourceEphemeralStorage: true,
+}
+
+// PodSchedulingHints captures the pod-level (as opposed to container-level)
+// scheduling knobs a source controller may want to set on the receive
+// adapter Deployment it builds.
+type PodSchedulingHints struct {
+	// PriorityClassName, if set, is propagated to the pod template so the
+	// adapter can be pinned to a priority band (e.g. to reduce preemption
+	// risk on a spot pool).
+	PriorityClassName string
+
+	// TopologySpreadConstraints, if set, is propagated to the pod template
+	// as-is.
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint
+
+	// QOSClass is derived from the resulting ResourceRequirements; it is
+	// informational only and is not itself set on the pod template (the
+	// kubelet derives it the same way).
+	QOSClass corev1.PodQOSClass
+}
+
+// ResourceProfile bundles the container ResourceRequirements together with
+// the pod-level scheduling hints needed to run a receive adapter under a
+// particular QoS/scheduling policy.
+type ResourceProfile struct {
+	Requirements    corev1.ResourceRequirements
+	SchedulingHints PodSchedulingHints
+}
+
+// Option configures a ResourceProfile being built by BuildResourceProfile.
+type Option func(*ResourceProfile)
+
+// WithEphemeralStorage adds ephemeral-storage request/limit quantities,
+// following the same lenient empty/invalid-is-omitted behavior as
+// BuildResourceRequirements.
+func WithEphemeralStorage(request, limit string) Option {
+	return func(p *ResourceProfile) {
+		setQuantity(p.Requirements.Requests, corev1.ResourceEphemeralStorage, request)
+		setQuantity(p.Requirements.Limits, corev1.ResourceEphemeralStorage, limit)
+	}
+}
+
+// WithExtendedResource adds a request/limit pair for an extended resource
+// such as "nvidia.com/gpu" or "hugepages-2Mi". Reserved resource names
+// (cpu, memory, ephemeral-storage) and empty names are dropped rather than
+// causing an error, since they should be configured through their own
+// dedicated options.
+func WithExtendedResource(name, request, limit string) Option {
+	return func(p *ResourceProfile) {
+		rn := corev1.ResourceName(name)
+		if name == "" || reservedResourceNames[rn] {
+			return
+		}
+		setQuantity(p.Requirements.Requests, rn, request)
+		setQuantity(p.Requirements.Limits, rn, limit)
+	}
+}
+
+// WithPriorityClassName sets the pod's PriorityClassName scheduling hint.
+func WithPriorityClassName(name string) Option {
+	return func(p *ResourceProfile) {
+		p.SchedulingHints.PriorityClassName = name
+	}
+}
+
+// WithTopologySpreadConstraints sets the pod's topology spread constraints.
+func WithTopologySpreadConstraints(constraints ...corev1.TopologySpreadConstraint) Option {
+	return func(p *ResourceProfile) {
+		p.SchedulingHints.TopologySpreadConstraints = constraints
+	}
+}
+
+// setQuantity parses value and, if valid, records it under name in list.
+// Empty or unparsable values are silently skipped, matching
+// BuildResourceRequirements.
+func setQuantity(list corev1.ResourceList, name corev1.ResourceName, value string) {
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return
+	}
+	list[name] = q
+}
+
+// BuildResourceProfile builds a ResourceProfile starting from the same four
+// CPU/memory knobs BuildResourceRequirements accepts, then applies opts on
+// top (ephemeral storage, extended resources, and pod scheduling hints).
+// The derived QOSClass hint reflects the final, fully-assembled
+// ResourceRequirements.
+func BuildResourceProfile(cpuRequest, cpuLimit, memoryRequest, memoryLimit string, opts ...Option) ResourceProfile {
+	profile := ResourceProfile{
+		Requirements: BuildResourceRequirements(cpuRequest, cpuLimit, memoryRequest, memoryLimit),
+	}
+	for _, opt := range opts {
+		opt(&profile)
+	}
+	profile.SchedulingHints.QOSClass = deriveQOSClass(profile.Requirements)
+	return profile
+}
+
+// deriveQOSClass mirrors the kubelet's pod QoS classification for a single
+// container's worth of ResourceRequirements. Like the kubelet, classification
+// is driven solely by CPU and memory: BestEffort if neither has a request or
+// limit set; Guaranteed if both have a request and a limit and, for each,
+// request equals limit; Burstable otherwise. Other resources (e.g.
+// ephemeral-storage, extended resources) never affect the result, even if
+// present with matching request/limit.
+func deriveQOSClass(rr corev1.ResourceRequirements) corev1.PodQOSClass {
+	cpuReq, hasCPUReq := rr.Requests[corev1.ResourceCPU]
+	memReq, hasMemReq := rr.Requests[corev1.ResourceMemory]
+	cpuLim, hasCPULim := rr.Limits[corev1.ResourceCPU]
+	memLim, hasMemLim := rr.Limits[corev1.ResourceMemory]
+
+	if !hasCPUReq && !hasMemReq && !hasCPULim && !hasMemLim {
+		return corev1.PodQOSBestEffort
+	}
+
+	if hasCPUReq && hasCPULim && hasMemReq && hasMemLim &&
+		cpuReq.Cmp(cpuLim) == 0 && memReq.Cmp(memLim) == 0 {
+		return corev1.PodQOSGuaranteed
+	}
+	return corev1.PodQOSBurstable
+}
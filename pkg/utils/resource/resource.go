@@ -0,0 +1,83 @@
+/*
+Copyright 2020 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resource builds corev1.ResourceRequirements (and related pod
+// scheduling knobs) for the receive adapter Deployments that each source
+// controller creates.
+package resource
+
+import (
+	"regexp"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// quantityRE splits a Quantity's canonical string form into its numeric
+// coefficient and unit suffix (e.g. "1500m" -> "1500", "m").
+var quantityRE = regexp.MustCompile(`^(-?[0-9.]+)(.*)$`)
+
+// BuildResourceRequirements builds a corev1.ResourceRequirements from the
+// given CPU/memory request/limit strings. Empty or unparsable values are
+// omitted rather than causing an error, since callers generally source
+// these from optional string fields on a Spec.
+func BuildResourceRequirements(cpuRequest, cpuLimit, memoryRequest, memoryLimit string) corev1.ResourceRequirements {
+	requests := corev1.ResourceList{}
+	limits := corev1.ResourceList{}
+
+	if q, err := resource.ParseQuantity(cpuRequest); err == nil {
+		requests[corev1.ResourceCPU] = q
+	}
+	if q, err := resource.ParseQuantity(cpuLimit); err == nil {
+		limits[corev1.ResourceCPU] = q
+	}
+	if q, err := resource.ParseQuantity(memoryRequest); err == nil {
+		requests[corev1.ResourceMemory] = q
+	}
+	if q, err := resource.ParseQuantity(memoryLimit); err == nil {
+		limits[corev1.ResourceMemory] = q
+	}
+
+	return corev1.ResourceRequirements{
+		Limits:   limits,
+		Requests: requests,
+	}
+}
+
+// MultiplyQuantity multiplies a resource.Quantity by the given multiple,
+// preserving its original format: the coefficient of initial's canonical
+// string is multiplied and re-parsed with the same suffix (e.g. "2Mi" times
+// 1000000000 is parsed as "2000000000Mi"). The result's String() is
+// canonical like any Quantity's, so it may print in a larger unit
+// ("1953125Gi"). If the product cannot be parsed, initial is returned.
+func MultiplyQuantity(initial resource.Quantity, multiple float64) *resource.Quantity {
+	parts := quantityRE.FindStringSubmatch(initial.String())
+	if parts == nil {
+		return &initial
+	}
+	num, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return &initial
+	}
+
+	result := strconv.FormatFloat(num*multiple, 'f', -1, 64) + parts[2]
+	q, err := resource.ParseQuantity(result)
+	if err != nil {
+		return &initial
+	}
+	return &q
+}
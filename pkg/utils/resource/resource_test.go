@@ -209,3 +209,135 @@ func TestMultiplyQuantity(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildResourceProfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected ResourceProfile
+	}{{
+		name: "CPU/memory only, no opts",
+		opts: nil,
+		expected: ResourceProfile{
+			Requirements: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1500m"),
+					corev1.ResourceMemory: resource.MustParse("3000Mi"),
+				},
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1500m"),
+					corev1.ResourceMemory: resource.MustParse("500Mi"),
+				},
+			},
+			SchedulingHints: PodSchedulingHints{QOSClass: corev1.PodQOSBurstable},
+		},
+	}, {
+		name: "Ephemeral storage is added",
+		opts: []Option{WithEphemeralStorage("1Gi", "2Gi")},
+		expected: ResourceProfile{
+			Requirements: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:             resource.MustParse("1500m"),
+					corev1.ResourceMemory:           resource.MustParse("3000Mi"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("2Gi"),
+				},
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:             resource.MustParse("1500m"),
+					corev1.ResourceMemory:           resource.MustParse("500Mi"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+				},
+			},
+			SchedulingHints: PodSchedulingHints{QOSClass: corev1.PodQOSBurstable},
+		},
+	}, {
+		name: "Extended resource is added",
+		opts: []Option{WithExtendedResource("nvidia.com/gpu", "1", "1")},
+		expected: ResourceProfile{
+			Requirements: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:                   resource.MustParse("1500m"),
+					corev1.ResourceMemory:                 resource.MustParse("3000Mi"),
+					corev1.ResourceName("nvidia.com/gpu"): resource.MustParse("1"),
+				},
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:                   resource.MustParse("1500m"),
+					corev1.ResourceMemory:                 resource.MustParse("500Mi"),
+					corev1.ResourceName("nvidia.com/gpu"): resource.MustParse("1"),
+				},
+			},
+			SchedulingHints: PodSchedulingHints{QOSClass: corev1.PodQOSBurstable},
+		},
+	}, {
+		name: "Reserved extended-resource keys are dropped",
+		opts: []Option{WithExtendedResource("memory", "100Mi", "100Mi")},
+		expected: ResourceProfile{
+			Requirements: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1500m"),
+					corev1.ResourceMemory: resource.MustParse("3000Mi"),
+				},
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1500m"),
+					corev1.ResourceMemory: resource.MustParse("500Mi"),
+				},
+			},
+			SchedulingHints: PodSchedulingHints{QOSClass: corev1.PodQOSBurstable},
+		},
+	}, {
+		name: "Empty extended-resource key is dropped",
+		opts: []Option{WithExtendedResource("", "1", "1")},
+		expected: ResourceProfile{
+			Requirements: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1500m"),
+					corev1.ResourceMemory: resource.MustParse("3000Mi"),
+				},
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1500m"),
+					corev1.ResourceMemory: resource.MustParse("500Mi"),
+				},
+			},
+			SchedulingHints: PodSchedulingHints{QOSClass: corev1.PodQOSBurstable},
+		},
+	}, {
+		name: "Priority class name hint is preserved",
+		opts: []Option{WithPriorityClassName("spot-safe")},
+		expected: ResourceProfile{
+			Requirements: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1500m"),
+					corev1.ResourceMemory: resource.MustParse("3000Mi"),
+				},
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1500m"),
+					corev1.ResourceMemory: resource.MustParse("500Mi"),
+				},
+			},
+			SchedulingHints: PodSchedulingHints{
+				PriorityClassName: "spot-safe",
+				QOSClass:          corev1.PodQOSBurstable,
+			},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := BuildResourceProfile("1500m", "1500m", "500Mi", "3000Mi", test.opts...)
+
+			if diff := cmp.Diff(test.expected, result); diff != "" {
+				t.Errorf("failed to get expected (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestDeriveQOSClassIgnoresNonComputeResources(t *testing.T) {
+	// A matching ephemeral-storage request/limit must not be reported as
+	// Guaranteed on its own: like the kubelet, QOSClass is driven solely by
+	// CPU and memory.
+	profile := BuildResourceProfile("", "", "", "", WithEphemeralStorage("1Gi", "1Gi"))
+
+	if got, want := profile.SchedulingHints.QOSClass, corev1.PodQOSBestEffort; got != want {
+		t.Errorf("QOSClass = %v, want %v", got, want)
+	}
+}